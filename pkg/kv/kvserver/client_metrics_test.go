@@ -11,6 +11,7 @@
 package kvserver_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strconv"
@@ -391,3 +392,69 @@ func TestStoreMetrics(t *testing.T) {
 		}
 	}
 }
+
+// TestStoreMetricsOversizedRanges verifies that the ranges.oversized gauge
+// counts ranges whose maximum size was lowered below their current size, and
+// that it drops back once such a range is split.
+func TestStoreMetricsOversizedRanges(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	tc := testcluster.StartTestCluster(t, 1,
+		base.TestClusterArgs{
+			ReplicationMode: base.ReplicationManual,
+			ServerArgs: base.TestServerArgs{
+				Knobs: base.TestingKnobs{
+					Store: &kvserver.StoreTestingKnobs{
+						// Keep the split queue from splitting the range on its own.
+						DisableSplitQueue: true,
+						DisableMergeQueue: true,
+					},
+				},
+			},
+		})
+	defer tc.Stopper().Stop(ctx)
+	store := tc.GetFirstStoreFromServer(t, 0)
+
+	checkOversized := func(exp int64) {
+		t.Helper()
+		require.NoError(t, store.ComputeMetrics(ctx))
+		checkGauge(t, "store 0", store.Metrics().OversizedRangeCount, exp)
+	}
+
+	key := tc.ScratchRange(t)
+	repl := store.LookupReplica(roachpb.RKey(key))
+	conf, err := repl.LoadSpanConfig(ctx)
+	require.NoError(t, err)
+
+	setMaxBytes := func(maxBytes int64) {
+		newConf := *conf
+		newConf.RangeMinBytes = 0
+		newConf.RangeMaxBytes = maxBytes
+		repl.SetSpanConfig(newConf, repl.Desc().RSpan().AsRawSpanWithNoLocals())
+	}
+	setMaxBytes(1 << 20)
+	checkOversized(0)
+
+	// Write 64KiB into the range, evenly spread over two halves which will each
+	// remain below the lowered maximum once the range is split.
+	makeKey := func(i int) roachpb.Key {
+		return append(key.Clone(), fmt.Sprintf("%03d", i)...)
+	}
+	value := bytes.Repeat([]byte("x"), 1<<10)
+	for i := 0; i < 64; i++ {
+		require.NoError(t, store.DB().Put(ctx, makeKey(i), value))
+	}
+	checkOversized(0)
+
+	// Lower the maximum below the size of the range. The range is granted a
+	// backpressure grace period, but it is still due to be split.
+	const maxBytes = 48 << 10
+	setMaxBytes(maxBytes)
+	require.Greater(t, repl.GetMVCCStats().Total(), int64(maxBytes))
+	checkOversized(1)
+
+	tc.SplitRangeOrFatal(t, makeKey(32))
+	checkOversized(0)
+}
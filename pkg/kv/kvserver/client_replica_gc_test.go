@@ -11,6 +11,7 @@
 package kvserver_test
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"path/filepath"
@@ -183,17 +184,59 @@ func TestReplicaGCQueueDropReplicaGCOnScan(t *testing.T) {
 	tc.AddVotersOrFatal(t, k, tc.Target(1), tc.Target(2))
 	require.NoError(t, tc.WaitForVoters(k, tc.Target(1), tc.Target(2)))
 
+	// Write some data to the range, so that removing the replica reclaims it,
+	// and wait for it to reach our replica.
+	value := bytes.Repeat([]byte("x"), 1<<10)
+	for i := 0; i < 4; i++ {
+		key := append(k[:len(k):len(k)], byte(i))
+		require.NoError(t, tc.Server(0).DB().Put(context.Background(), key, value))
+	}
+	rangeID := tc.LookupRangeOrFatal(t, k).RangeID
+	leaseholder := tc.GetFirstStoreFromServer(t, 0)
+	testutils.SucceedsSoon(t, func() error {
+		expected, err := leaseholder.GetReplica(rangeID)
+		if err != nil {
+			return err
+		}
+		repl, err := store.GetReplica(rangeID)
+		if err != nil {
+			return err
+		}
+		if e, a := expected.GetMVCCStats().Total(), repl.GetMVCCStats().Total(); e != a {
+			return errors.Errorf("expected %d bytes on replica, found %d", e, a)
+		}
+		return nil
+	})
+
 	desc := tc.RemoveVotersOrFatal(t, k, tc.Target(1))
 
 	// Wait long enough for the direct replica GC to have had a chance and been
 	// discarded because the queue is disabled.
 	time.Sleep(10 * time.Millisecond)
-	if _, err := store.GetReplica(desc.RangeID); err != nil {
-		t.Error("unexpected range removal")
+	repl, err := store.GetReplica(desc.RangeID)
+	if err != nil {
+		t.Fatal("unexpected range removal")
+	}
+	// This is the data the queue reclaims when it removes the replica.
+	replBytes := repl.GetMVCCStats().Total()
+	require.Positive(t, replBytes)
+
+	// The range removes and merges recorded across the cluster, which replica GC
+	// must not contribute to.
+	rangeRemovesAndMerges := func() (removes, merges int64) {
+		for i := 0; i < tc.NumServers(); i++ {
+			m := tc.GetFirstStoreFromServer(t, i).Metrics()
+			removes += m.RangeRemoves.Count()
+			merges += m.RangeMerges.Count()
+		}
+		return removes, merges
 	}
+	prevRangeRemoves, prevRangeMerges := rangeRemovesAndMerges()
 
 	// Enable the queue.
 	store.SetReplicaGCQueueActive(true)
+	prevRemovals := store.ReplicaGCQueueMetrics().RemoveReplicaCount.Count()
+	prevBytes := store.ReplicaGCQueueMetrics().RemoveReplicaBytes.Count()
 
 	// Make sure the range is removed from the store.
 	testutils.SucceedsSoon(t, func() error {
@@ -204,4 +247,12 @@ func TestReplicaGCQueueDropReplicaGCOnScan(t *testing.T) {
 		}
 		return nil
 	})
+
+	// The removal, and the data it reclaimed, are attributed to replica GC.
+	require.Greater(t, store.ReplicaGCQueueMetrics().RemoveReplicaCount.Count(), prevRemovals)
+	require.Equal(t, prevBytes+replBytes, store.ReplicaGCQueueMetrics().RemoveReplicaBytes.Count())
+	// It is not counted as a range removal or merge.
+	rangeRemoves, rangeMerges := rangeRemovesAndMerges()
+	require.Equal(t, prevRangeRemoves, rangeRemoves)
+	require.Equal(t, prevRangeMerges, rangeMerges)
 }
@@ -4115,6 +4115,9 @@ func TestStoreRangeSplitAndMergeWithGlobalReads(t *testing.T) {
 	_, pErr = kv.SendWrapped(ctx, store.TestSender(), splitArgs)
 	require.Nil(t, pErr)
 	require.Equal(t, int64(1), store.Metrics().CommitWaitsBeforeCommitTrigger.Count())
+	count, sum := store.Metrics().CommitWaitBeforeCommitTriggerDuration.CumulativeSnapshot().Total()
+	require.Equal(t, int64(1), count)
+	require.Positive(t, sum)
 	require.Equal(t, int64(1), atomic.LoadInt64(&splits))
 
 	repl = store.LookupReplica(roachpb.RKey(splitKey))
@@ -4125,6 +4128,9 @@ func TestStoreRangeSplitAndMergeWithGlobalReads(t *testing.T) {
 	_, pErr = kv.SendWrapped(ctx, store.TestSender(), mergeArgs)
 	require.Nil(t, pErr)
 	require.Equal(t, int64(2), store.Metrics().CommitWaitsBeforeCommitTrigger.Count())
+	count, sum = store.Metrics().CommitWaitBeforeCommitTriggerDuration.CumulativeSnapshot().Total()
+	require.Equal(t, int64(2), count)
+	require.Positive(t, sum)
 	require.Equal(t, int64(1), atomic.LoadInt64(&merges))
 
 	repl = store.LookupReplica(roachpb.RKey(splitKey))
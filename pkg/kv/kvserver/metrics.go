@@ -120,6 +120,12 @@ var (
 		Measurement: "Ranges",
 		Unit:        metric.Unit_COUNT,
 	}
+	metaOversizedRangeCount = metric.Metadata{
+		Name:        "ranges.oversized",
+		Help:        "Number of ranges larger than their configured maximum size which are pending a split",
+		Measurement: "Ranges",
+		Unit:        metric.Unit_COUNT,
+	}
 
 	// Lease request metrics.
 	metaLeaseRequestSuccessCount = metric.Metadata{
@@ -442,6 +448,13 @@ var (
 		Measurement: "KV Transactions",
 		Unit:        metric.Unit_COUNT,
 	}
+	metaCommitWaitBeforeCommitTriggerDuration = metric.Metadata{
+		Name: "txn.commit_waits.before_commit_trigger.duration",
+		Help: "Duration of the server-side commit-wait sleeps performed by KV " +
+			"transactions with a commit trigger",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
 	metaWriteEvaluationServerSideRetrySuccess = metric.Metadata{
 		Name:        "txn.server_side_retry.write_evaluation.success",
 		Help:        "Number of write batches that were successfully refreshed server side",
@@ -2554,6 +2567,7 @@ type StoreMetrics struct {
 	UnavailableRangeCount     *metric.Gauge
 	UnderReplicatedRangeCount *metric.Gauge
 	OverReplicatedRangeCount  *metric.Gauge
+	OversizedRangeCount       *metric.Gauge
 
 	// Lease request metrics for successful and failed lease requests. These
 	// count proposals (i.e. it does not matter how many replicas apply the
@@ -2599,6 +2613,7 @@ type StoreMetrics struct {
 
 	// Server-side transaction metrics.
 	CommitWaitsBeforeCommitTrigger                           *metric.Counter
+	CommitWaitBeforeCommitTriggerDuration                    metric.IHistogram
 	WriteEvaluationServerSideRetrySuccess                    *metric.Counter
 	WriteEvaluationServerSideRetryFailure                    *metric.Counter
 	ReadEvaluationServerSideRetrySuccess                     *metric.Counter
@@ -3251,6 +3266,7 @@ func newStoreMetrics(histogramWindow time.Duration) *StoreMetrics {
 		UnavailableRangeCount:     metric.NewGauge(metaUnavailableRangeCount),
 		UnderReplicatedRangeCount: metric.NewGauge(metaUnderReplicatedRangeCount),
 		OverReplicatedRangeCount:  metric.NewGauge(metaOverReplicatedRangeCount),
+		OversizedRangeCount:       metric.NewGauge(metaOversizedRangeCount),
 
 		// Lease request metrics.
 		LeaseRequestSuccessCount: metric.NewCounter(metaLeaseRequestSuccessCount),
@@ -3305,7 +3321,13 @@ func newStoreMetrics(histogramWindow time.Duration) *StoreMetrics {
 		FollowerReadsCount: metric.NewCounter(metaFollowerReadsCount),
 
 		// Server-side transaction metrics.
-		CommitWaitsBeforeCommitTrigger:                           metric.NewCounter(metaCommitWaitBeforeCommitTriggerCount),
+		CommitWaitsBeforeCommitTrigger: metric.NewCounter(metaCommitWaitBeforeCommitTriggerCount),
+		CommitWaitBeforeCommitTriggerDuration: metric.NewHistogram(metric.HistogramOptions{
+			Mode:         metric.HistogramModePreferHdrLatency,
+			Metadata:     metaCommitWaitBeforeCommitTriggerDuration,
+			Duration:     histogramWindow,
+			BucketConfig: metric.IOLatencyBuckets,
+		}),
 		WriteEvaluationServerSideRetrySuccess:                    metric.NewCounter(metaWriteEvaluationServerSideRetrySuccess),
 		WriteEvaluationServerSideRetryFailure:                    metric.NewCounter(metaWriteEvaluationServerSideRetryFailure),
 		ReadEvaluationServerSideRetrySuccess:                     metric.NewCounter(metaReadEvaluationServerSideRetrySuccess),
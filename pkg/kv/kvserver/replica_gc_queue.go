@@ -64,16 +64,24 @@ var (
 		Measurement: "Replica Removals",
 		Unit:        metric.Unit_COUNT,
 	}
+	metaReplicaGCQueueRemoveReplicaBytes = metric.Metadata{
+		Name:        "queue.replicagc.removereplica.bytes",
+		Help:        "Number of bytes of replica data removed by the replica GC queue",
+		Measurement: "Storage",
+		Unit:        metric.Unit_BYTES,
+	}
 )
 
 // ReplicaGCQueueMetrics is the set of metrics for the replica GC queue.
 type ReplicaGCQueueMetrics struct {
 	RemoveReplicaCount *metric.Counter
+	RemoveReplicaBytes *metric.Counter
 }
 
 func makeReplicaGCQueueMetrics() ReplicaGCQueueMetrics {
 	return ReplicaGCQueueMetrics{
 		RemoveReplicaCount: metric.NewCounter(metaReplicaGCQueueRemoveReplicaCount),
+		RemoveReplicaBytes: metric.NewCounter(metaReplicaGCQueueRemoveReplicaBytes),
 	}
 }
 
@@ -311,6 +319,8 @@ func (rgcq *replicaGCQueue) process(
 		rgcq.metrics.RemoveReplicaCount.Inc(1)
 		log.VEventf(ctx, 1, "destroying local data")
 
+		// Capture the replica's size before its data is destroyed.
+		ms := repl.GetMVCCStats()
 		nextReplicaID := replyDesc.NextReplicaID
 		// Note that this seems racy - we didn't hold any locks between reading
 		// the range descriptor above and deciding to remove the replica - but
@@ -329,6 +339,7 @@ func (rgcq *replicaGCQueue) process(
 			logcrash.ReportOrPanic(ctx, &repl.store.ClusterSettings().SV, format, err)
 			return false, err
 		}
+		rgcq.metrics.RemoveReplicaBytes.Inc(ms.Total())
 	} else {
 		// This case is tricky. This range has been merged away, so it is likely
 		// that we can GC this replica, but we need to be careful. If this store has
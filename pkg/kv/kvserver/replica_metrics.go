@@ -51,6 +51,7 @@ type ReplicaMetrics struct {
 	Unavailable              bool
 	Underreplicated          bool
 	Overreplicated           bool
+	Oversized                bool
 	RaftLogTooLarge          bool
 	BehindCount              int64
 	PausedFollowerCount      int64
@@ -114,6 +115,7 @@ func (r *Replica) Metrics(
 		paused:                   r.mu.pausedFollowers,
 		pendingRaftProposalCount: r.numPendingProposalsRLocked(),
 		slowRaftProposalCount:    r.mu.slowProposalCount,
+		rangeSize:                r.mu.state.Stats.Total(),
 	}
 
 	r.mu.RUnlock()
@@ -142,6 +144,7 @@ type calcReplicaMetricsInput struct {
 	paused                   map[roachpb.ReplicaID]struct{}
 	pendingRaftProposalCount int64
 	slowRaftProposalCount    int64
+	rangeSize                int64
 }
 
 func calcReplicaMetrics(d calcReplicaMetricsInput) ReplicaMetrics {
@@ -192,6 +195,10 @@ func calcReplicaMetrics(d calcReplicaMetricsInput) ReplicaMetrics {
 		Unavailable:               unavailable,
 		Underreplicated:           underreplicated,
 		Overreplicated:            overreplicated,
+		// Like the split queue, this compares against the current maximum range
+		// size, ignoring the backpressure grace given after it was lowered.
+		Oversized: rangeCounter && d.conf.RangeMaxBytes > 0 &&
+			d.rangeSize > d.conf.RangeMaxBytes,
 		RaftLogTooLarge: d.raftLogSizeTrusted &&
 			d.raftLogSize > raftLogTooLargeMultiple*d.raftCfg.RaftLogTruncationThreshold,
 		BehindCount:              leaderBehindCount,
@@ -298,6 +298,7 @@ func (r *Replica) maybeCommitWaitBeforeCommitTrigger(
 	after := r.Clock().PhysicalTime()
 	log.VEventf(ctx, 1, "completed server-side commit-wait sleep, took %s", after.Sub(before))
 	r.store.metrics.CommitWaitsBeforeCommitTrigger.Inc(1)
+	r.store.metrics.CommitWaitBeforeCommitTriggerDuration.RecordValue(after.Sub(before).Nanoseconds())
 	return nil
 }
 
@@ -3132,6 +3132,11 @@ func (s *Store) ReplicateQueueMetrics() ReplicateQueueMetrics {
 	return s.replicateQueue.metrics
 }
 
+// ReplicaGCQueueMetrics returns the store's replicaGCQueue metric struct.
+func (s *Store) ReplicaGCQueueMetrics() ReplicaGCQueueMetrics {
+	return s.replicaGCQueue.metrics
+}
+
 // Descriptor returns a StoreDescriptor including current store
 // capacity information.
 func (s *Store) Descriptor(ctx context.Context, useCached bool) (*roachpb.StoreDescriptor, error) {
@@ -3214,6 +3219,7 @@ func (s *Store) updateReplicationGauges(ctx context.Context) error {
 		unavailableRangeCount     int64
 		underreplicatedRangeCount int64
 		overreplicatedRangeCount  int64
+		oversizedRangeCount       int64
 		behindCount               int64
 		pausedFollowerCount       int64
 		ioOverload                float64
@@ -3296,6 +3302,9 @@ func (s *Store) updateReplicationGauges(ctx context.Context) error {
 			if metrics.Overreplicated {
 				overreplicatedRangeCount++
 			}
+			if metrics.Oversized {
+				oversizedRangeCount++
+			}
 		}
 		pausedFollowerCount += metrics.PausedFollowerCount
 		pendingRaftProposalCount += metrics.PendingRaftProposalCount
@@ -3359,6 +3368,7 @@ func (s *Store) updateReplicationGauges(ctx context.Context) error {
 	s.metrics.UnavailableRangeCount.Update(unavailableRangeCount)
 	s.metrics.UnderReplicatedRangeCount.Update(underreplicatedRangeCount)
 	s.metrics.OverReplicatedRangeCount.Update(overreplicatedRangeCount)
+	s.metrics.OversizedRangeCount.Update(oversizedRangeCount)
 	s.metrics.RaftLogFollowerBehindCount.Update(behindCount)
 	s.metrics.RaftPausedFollowerCount.Update(pausedFollowerCount)
 	s.metrics.IOOverload.Update(ioOverload)
@@ -43,6 +43,7 @@ func newTestMetricSource(clock hlc.WallClock) metricMarshaler {
 		nil, /* remoteClocks */
 		clock,
 		st,
+		nil, /* sink */
 	)
 	nodeDesc := roachpb.NodeDescriptor{
 		NodeID: roachpb.NodeID(7),
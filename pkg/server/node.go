@@ -1411,7 +1411,7 @@ func (n *Node) writeNodeStatus(ctx context.Context, alertTTL time.Duration, must
 			// state (since it'll be incremented every ~10s).
 		}
 
-		err = n.recorder.WriteNodeStatus(ctx, n.storeCfg.DB, *nodeStatus, mustExist)
+		err = n.recorder.WriteNodeStatus(ctx, *nodeStatus, mustExist)
 	}); runErr != nil {
 		err = runErr
 	}
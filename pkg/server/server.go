@@ -889,6 +889,7 @@ func NewServer(cfg Config, stopper *stop.Stopper) (serverctl.ServerStartupInterf
 		rpcContext.RemoteClocks,
 		clock.WallClock(),
 		st,
+		status.NewKVStatusSink(db),
 	)
 	appRegistry.AddMetricStruct(rpcContext.RemoteClocks.Metrics())
 
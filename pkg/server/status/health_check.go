@@ -22,8 +22,15 @@ import (
 type threshold struct {
 	gauge bool
 	min   int64
+	// growth, if nonzero, makes a gauge report only once it has increased on
+	// this many consecutive calls to CheckHealth.
+	growth int
 }
 
+// growthSuffix is appended to the name of a gauge with a growth threshold to
+// record, alongside its last seen value, the number of consecutive increases.
+const growthSuffix = ".growth"
+
 var (
 	counterZero = threshold{}
 	gaugeZero   = threshold{gauge: true}
@@ -87,14 +94,20 @@ var trackedMetrics = map[string]threshold{
 	// When there are more than 100 pending items in the Raft snapshot queue,
 	// this is certainly worth pointing out.
 	"queue.raftsnapshot.pending": {gauge: true, min: 100},
+
+	// Ranges that exceed their maximum size are normally split promptly by the
+	// split queue. Bulk operations can push many of them over at once, so only
+	// a count that keeps growing for a minute indicates a split backlog.
+	"ranges.oversized": {gauge: true, growth: 6},
 }
 
 type metricsMap map[roachpb.StoreID]map[string]float64
 
 // update takes a populated metrics map and extracts the tracked metrics. Gauges
 // are returned verbatim, while for counters the diff between the last seen
-// value is returned. Only nonzero values are reported and the seen (non-relative)
-// values are persisted for the next call.
+// value is returned. Gauges with a growth threshold are only returned once
+// they have increased on enough consecutive calls. Only nonzero values are
+// reported and the seen (non-relative) values are persisted for the next call.
 func (d metricsMap) update(tracked map[string]threshold, m metricsMap) metricsMap {
 	out := metricsMap{}
 	for storeID := range m {
@@ -104,6 +117,22 @@ func (d metricsMap) update(tracked map[string]threshold, m metricsMap) metricsMa
 				continue
 			}
 
+			if threshold.gauge && threshold.growth > 0 {
+				prevVal, havePrev := d[storeID][name]
+				if d[storeID] == nil {
+					d[storeID] = map[string]float64{}
+				}
+				d[storeID][name] = val
+				if havePrev && val > prevVal {
+					d[storeID][name+growthSuffix]++
+				} else {
+					d[storeID][name+growthSuffix] = 0
+				}
+				if d[storeID][name+growthSuffix] < float64(threshold.growth) {
+					continue
+				}
+			}
+
 			if !threshold.gauge {
 				prevVal, havePrev := d[storeID][name]
 				if d[storeID] == nil {
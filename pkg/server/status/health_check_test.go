@@ -148,3 +148,41 @@ func TestHealthCheckMetricsMap(t *testing.T) {
 	check(m.update(tracked, metricsMap{1: {"banana": 300}}), metricsMap{})
 	check(m, finalMap)
 }
+
+func TestHealthCheckMetricsMapGrowth(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	m := metricsMap{}
+
+	tracked := map[string]threshold{
+		"gauge": {gauge: true, growth: 2},
+	}
+
+	check := func(act, exp metricsMap) {
+		t.Helper()
+		if diff := pretty.Diff(act, exp); len(diff) != 0 {
+			t.Fatalf("diff(act,exp) = %s\n\nact=%+v\nexp=%+v", strings.Join(diff, "\n"), act, exp)
+		}
+	}
+
+	// A large value, such as a burst following a bulk operation, is not reported
+	// on its own.
+	check(m.update(tracked, metricsMap{1: {"gauge": 50}}), metricsMap{})
+	check(m, metricsMap{1: {"gauge": 50, "gauge.growth": 0}})
+
+	// Neither is a single increase.
+	check(m.update(tracked, metricsMap{1: {"gauge": 60}}), metricsMap{})
+	check(m, metricsMap{1: {"gauge": 60, "gauge.growth": 1}})
+
+	// The gauge is reported once it has grown on consecutive calls, and for as
+	// long as it keeps growing.
+	check(m.update(tracked, metricsMap{1: {"gauge": 70}}), metricsMap{1: {"gauge": 70}})
+	check(m.update(tracked, metricsMap{1: {"gauge": 71}}), metricsMap{1: {"gauge": 71}})
+
+	// Once it stops growing, it has to grow for as long again to be reported.
+	check(m.update(tracked, metricsMap{1: {"gauge": 71}}), metricsMap{})
+	check(m.update(tracked, metricsMap{1: {"gauge": 72}}), metricsMap{})
+	check(m.update(tracked, metricsMap{1: {"gauge": 73}}), metricsMap{1: {"gauge": 73}})
+	check(m.update(tracked, metricsMap{1: {"gauge": 10}}), metricsMap{})
+	check(m, metricsMap{1: {"gauge": 10, "gauge.growth": 0}})
+}
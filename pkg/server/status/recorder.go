@@ -19,16 +19,15 @@ import (
 	"math"
 	"os"
 	"runtime"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/cockroach/pkg/build"
-	"github.com/cockroachdb/cockroach/pkg/keys"
-	"github.com/cockroachdb/cockroach/pkg/kv"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvbase"
-	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/liveness"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/liveness/livenesspb"
 	"github.com/cockroachdb/cockroach/pkg/multitenant"
@@ -49,6 +48,7 @@ import (
 	// metrics functionality into pkg/util/log.
 	_ "github.com/cockroachdb/cockroach/pkg/util/log/logmetrics"
 	"github.com/cockroachdb/cockroach/pkg/util/metric"
+	"github.com/cockroachdb/cockroach/pkg/util/retry"
 	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
 	"github.com/cockroachdb/cockroach/pkg/util/system"
 	"github.com/cockroachdb/errors"
@@ -78,6 +78,26 @@ const (
 // custom scrape logic that adds relevant labels already.
 var disableNodeAndTenantLabels = envutil.EnvOrDefaultBool(disableNodeAndTenantLabelsEnvVar, false)
 
+var metaNodeStatusWriteErrors = metric.Metadata{
+	Name:        "status.write.errors",
+	Help:        "Number of failed attempts to write the node status summary",
+	Measurement: "Errors",
+	Unit:        metric.Unit_COUNT,
+}
+
+// defaultWriteNodeStatusRetryOptions bounds the retries of a failed node
+// status write. The status is written periodically, so there is no need to
+// retry for longer than one sampling interval. The initial write on node
+// startup is additionally retried indefinitely by the caller; these retries
+// nest within it on purpose, riding out brief errors without going through
+// the startup retry loop.
+var defaultWriteNodeStatusRetryOptions = retry.Options{
+	InitialBackoff: 50 * time.Millisecond,
+	MaxBackoff:     time.Second,
+	Multiplier:     2,
+	MaxRetries:     3,
+}
+
 // storeMetrics is the minimum interface of the storage.Store object needed by
 // MetricsRecorder to provide status summaries. This is used instead of Store
 // directly in order to simplify testing.
@@ -108,6 +128,14 @@ type MetricsRecorder struct {
 	settings     *cluster.Settings
 	clock        hlc.WallClock
 
+	// sink is the destination of the summaries written by WriteNodeStatus.
+	sink StatusSink
+	// writeRetryOpts controls the retries of failed writes to the sink.
+	writeRetryOpts retry.Options
+	// writeErrors counts the failed attempts to write to the sink, excluding
+	// those rejected because of mustExist.
+	writeErrors *metric.Counter
+
 	// Counts to help optimize slice allocation. Should only be accessed atomically.
 	lastDataCount        int64
 	lastSummaryCount     int64
@@ -172,6 +200,10 @@ type MetricsRecorder struct {
 // If both nodeLiveness and remoteClocks are not-nil, the node status generated
 // by GenerateNodeStatus() will contain info about RPC lantency to all currently
 // live nodes.
+//
+// The sink is the destination of the summaries written by WriteNodeStatus. It
+// may be nil if the recorder never writes summaries, in which case
+// WriteNodeStatus returns an error.
 func NewMetricsRecorder(
 	tenantID roachpb.TenantID,
 	tenantNameContainer *roachpb.TenantNameContainer,
@@ -179,6 +211,7 @@ func NewMetricsRecorder(
 	remoteClocks *rpc.RemoteClockMonitor,
 	clock hlc.WallClock,
 	settings *cluster.Settings,
+	sink StatusSink,
 ) *MetricsRecorder {
 	mr := &MetricsRecorder{
 		HealthChecker:       NewHealthChecker(trackedMetrics),
@@ -186,6 +219,9 @@ func NewMetricsRecorder(
 		remoteClocks:        remoteClocks,
 		settings:            settings,
 		clock:               clock,
+		sink:                sink,
+		writeRetryOpts:      defaultWriteNodeStatusRetryOptions,
+		writeErrors:         metric.NewCounter(metaNodeStatusWriteErrors),
 		tenantID:            tenantID,
 		tenantNameContainer: tenantNameContainer,
 		prometheusExporter:  metric.MakePrometheusExporter(),
@@ -267,6 +303,9 @@ func (mr *MetricsRecorder) AddNode(
 	nodeIDGauge := metric.NewGauge(metadata)
 	nodeIDGauge.Update(int64(desc.NodeID))
 	nodeReg.AddMetric(nodeIDGauge)
+	if mr.sink != nil {
+		nodeReg.AddMetric(mr.writeErrors)
+	}
 
 	if !disableNodeAndTenantLabels {
 		nodeIDInt := int(desc.NodeID)
@@ -377,6 +416,62 @@ func (mr *MetricsRecorder) ExportToGraphite(
 	return graphiteExporter.Push(ctx, endpoint)
 }
 
+var (
+	// influxMeasurementEscaper escapes the characters which are special in
+	// the measurement names of InfluxDB's line protocol.
+	influxMeasurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	// influxKeyEscaper escapes the characters which are special in the tag
+	// and field keys of InfluxDB's line protocol.
+	influxKeyEscaper = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+)
+
+// WriteInfluxLineProtocol writes the current values of the store-level
+// metrics to the writer in InfluxDB line protocol. One line is written per
+// store under the given measurement, tagged with the node and store IDs, with
+// each metric as a field and the recorder's current time as the timestamp.
+// As in PrintAsText, the output is buffered so that a slow writer doesn't
+// hold the lock.
+func (mr *MetricsRecorder) WriteInfluxLineProtocol(w io.Writer, measurement string) error {
+	var buf bytes.Buffer
+	func() {
+		mr.mu.RLock()
+		defer mr.mu.RUnlock()
+
+		if mr.mu.nodeRegistry == nil {
+			// We haven't yet processed initialization information; do nothing.
+			return
+		}
+
+		storeIDs := make([]roachpb.StoreID, 0, len(mr.mu.storeRegistries))
+		for storeID := range mr.mu.storeRegistries {
+			storeIDs = append(storeIDs, storeID)
+		}
+		sort.Slice(storeIDs, func(i, j int) bool { return storeIDs[i] < storeIDs[j] })
+
+		now := mr.clock.Now().UnixNano()
+		for _, storeID := range storeIDs {
+			var fields []string
+			eachRecordableValue(mr.mu.storeRegistries[storeID], func(name string, val float64) {
+				// The line protocol has no representation for these.
+				if math.IsNaN(val) || math.IsInf(val, 0) {
+					return
+				}
+				fields = append(fields, influxKeyEscaper.Replace(name)+"="+strconv.FormatFloat(val, 'f', -1, 64))
+			})
+			if len(fields) == 0 {
+				// A line must have at least one field.
+				continue
+			}
+			sort.Strings(fields)
+			fmt.Fprintf(&buf, "%s,node_id=%d,store=%d %s %d\n",
+				influxMeasurementEscaper.Replace(measurement), mr.mu.desc.NodeID, storeID,
+				strings.Join(fields, ","), now)
+		}
+	}()
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 // GetTimeSeriesData serializes registered metrics for consumption by
 // CockroachDB's time series system. GetTimeSeriesData implements the DataSource
 // interface of the ts package.
@@ -629,42 +724,32 @@ func flattenStrings(s []redact.RedactableString) []string {
 	return res
 }
 
-// WriteNodeStatus writes the supplied summary to the given client. If mustExist
-// is true, the key must already exist and must not change while being updated,
-// otherwise an error is returned -- if false, the status is always written.
+// WriteNodeStatus writes the supplied summary to the recorder's sink. If
+// mustExist is true, the summary must already exist and must not change while
+// being updated, otherwise an error is returned -- if false, the summary is
+// always written. Failed writes are retried with backoff, except for those
+// which failed because of mustExist.
 func (mr *MetricsRecorder) WriteNodeStatus(
-	ctx context.Context, db *kv.DB, nodeStatus statuspb.NodeStatus, mustExist bool,
+	ctx context.Context, nodeStatus statuspb.NodeStatus, mustExist bool,
 ) error {
+	if mr.sink == nil {
+		return errors.AssertionFailedf("node status written by a recorder without a sink")
+	}
 	mr.writeSummaryMu.Lock()
 	defer mr.writeSummaryMu.Unlock()
-	key := keys.NodeStatusKey(nodeStatus.Desc.NodeID)
-	// We use an inline value to store only a single version of the node status.
-	// There's not much point in keeping the historical versions as we keep
-	// all of the constituent data as timeseries. Further, due to the size
-	// of the build info in the node status, writing one of these every 10s
-	// will generate more versions than will easily fit into a range over
-	// the course of a day.
-	if mustExist {
-		entry, err := db.Get(ctx, key)
-		if err != nil {
-			return err
+	var err error
+	for r := retry.StartWithCtx(ctx, mr.writeRetryOpts); r.Next(); {
+		if err = mr.sink.WriteNodeStatus(ctx, &nodeStatus, mustExist); err == nil {
+			break
 		}
-		if entry.Value == nil {
-			return errors.New("status entry not found, node may have been decommissioned")
-		}
-		err = db.CPutInline(ctx, key, &nodeStatus, entry.Value.TagAndDataBytes())
-		if detail := (*kvpb.ConditionFailedError)(nil); errors.As(err, &detail) {
-			if detail.ActualValue == nil {
-				return errors.New("status entry not found, node may have been decommissioned")
-			}
-			return errors.New("status entry unexpectedly changed during update")
-		} else if err != nil {
-			return err
-		}
-	} else {
-		if err := db.PutInline(ctx, key, &nodeStatus); err != nil {
+		if errors.IsAny(err, errNodeStatusNotFound, errNodeStatusChanged) {
 			return err
 		}
+		mr.writeErrors.Inc(1)
+		log.VEventf(ctx, 1, "failed to write node status, attempt %d: %v", r.CurrentAttempt()+1, err)
+	}
+	if err != nil {
+		return err
 	}
 	if log.V(2) {
 		statusJSON, err := json.Marshal(&nodeStatus)
@@ -34,8 +34,10 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
 	"github.com/cockroachdb/cockroach/pkg/util/metric"
 	"github.com/cockroachdb/cockroach/pkg/util/metric/aggmetric"
+	"github.com/cockroachdb/cockroach/pkg/util/retry"
 	"github.com/cockroachdb/cockroach/pkg/util/system"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/cockroachdb/errors"
 	"github.com/kr/pretty"
 	prometheusgo "github.com/prometheus/client_model/go"
 	"github.com/prometheus/common/expfmt"
@@ -119,6 +121,7 @@ func TestMetricsRecorderLabels(t *testing.T) {
 		nil, /* remoteClocks */
 		manual,
 		st,
+		nil, /* sink */
 	)
 	appReg := metric.NewRegistry()
 	logReg := metric.NewRegistry()
@@ -141,6 +144,7 @@ func TestMetricsRecorderLabels(t *testing.T) {
 		nil, /* remoteClocks */
 		manual,
 		stTenant,
+		nil, /* sink */
 	)
 	recorderTenant.AddNode(
 		regTenant,
@@ -294,7 +298,7 @@ func TestRegistryRecorder_RecordChild(t *testing.T) {
 	systemTenantNameContainer := roachpb.NewTenantNameContainer(catconstants.SystemTenantName)
 	manual := timeutil.NewManualTime(timeutil.Unix(0, 100))
 	st := cluster.MakeTestingClusterSettings()
-	recorder := NewMetricsRecorder(roachpb.SystemTenantID, systemTenantNameContainer, nil, nil, manual, st)
+	recorder := NewMetricsRecorder(roachpb.SystemTenantID, systemTenantNameContainer, nil, nil, manual, st, nil)
 	recorder.AddStore(store1)
 	recorder.AddStore(store2)
 
@@ -453,7 +457,7 @@ func TestMetricsRecorder(t *testing.T) {
 	}
 	manual := timeutil.NewManualTime(timeutil.Unix(0, 100))
 	st := cluster.MakeTestingClusterSettings()
-	recorder := NewMetricsRecorder(roachpb.SystemTenantID, roachpb.NewTenantNameContainer(""), nil, nil, manual, st)
+	recorder := NewMetricsRecorder(roachpb.SystemTenantID, roachpb.NewTenantNameContainer(""), nil, nil, manual, st, nil)
 	recorder.AddStore(store1)
 	recorder.AddStore(store2)
 	appReg := metric.NewRegistry()
@@ -711,6 +715,116 @@ func TestMetricsRecorder(t *testing.T) {
 	recorder.mu.RUnlock()
 }
 
+// TestMetricsRecorderWriteNodeStatus verifies that the recorder writes node
+// statuses through its sink, and that failed writes are retried unless they
+// failed because the status must already exist.
+func TestMetricsRecorderWriteNodeStatus(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	ctx := context.Background()
+	nodeDesc := roachpb.NodeDescriptor{
+		NodeID: roachpb.NodeID(1),
+	}
+	manual := timeutil.NewManualTime(timeutil.Unix(0, 100))
+	st := cluster.MakeTestingClusterSettings()
+	sink := NewMemStatusSink()
+	recorder := NewMetricsRecorder(
+		roachpb.SystemTenantID, roachpb.NewTenantNameContainer(""), nil, nil, manual, st, sink)
+	recorder.writeRetryOpts = retry.Options{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		MaxRetries:     2,
+	}
+	recorder.AddNode(
+		metric.NewRegistry(), metric.NewRegistry(), metric.NewRegistry(), metric.NewRegistry(),
+		nodeDesc, 50, "foo:26257", "foo:26258", "foo:5432")
+
+	nodeStatus := recorder.GenerateNodeStatus(ctx)
+	require.NotNil(t, nodeStatus)
+
+	// The status hasn't been written yet, so it can't be updated. There is no
+	// point in retrying this, and it isn't a failure of the sink.
+	err := recorder.WriteNodeStatus(ctx, *nodeStatus, true /* mustExist */)
+	require.True(t, errors.Is(err, errNodeStatusNotFound), "%v", err)
+	require.Equal(t, int64(0), recorder.writeErrors.Count())
+
+	// Other errors are retried.
+	sink.InjectErrors(errors.New("boom"), errors.New("boom"))
+	require.NoError(t, recorder.WriteNodeStatus(ctx, *nodeStatus, false /* mustExist */))
+	require.Equal(t, int64(2), recorder.writeErrors.Count())
+	written, ok := sink.NodeStatus(nodeDesc.NodeID)
+	require.True(t, ok)
+	require.Equal(t, nodeStatus.UpdatedAt, written.UpdatedAt)
+
+	// Once the retries are exhausted, the last error is returned and the
+	// written status is left untouched.
+	manual.Advance(time.Second)
+	nodeStatus = recorder.GenerateNodeStatus(ctx)
+	sink.InjectErrors(errors.New("boom"), errors.New("boom"), errors.New("boom"))
+	err = recorder.WriteNodeStatus(ctx, *nodeStatus, true /* mustExist */)
+	require.ErrorContains(t, err, "boom")
+	require.Equal(t, int64(5), recorder.writeErrors.Count())
+	written, _ = sink.NodeStatus(nodeDesc.NodeID)
+	require.NotEqual(t, nodeStatus.UpdatedAt, written.UpdatedAt)
+
+	// Now that the status exists, it can be updated.
+	require.NoError(t, recorder.WriteNodeStatus(ctx, *nodeStatus, true /* mustExist */))
+	require.Equal(t, int64(5), recorder.writeErrors.Count())
+	written, _ = sink.NodeStatus(nodeDesc.NodeID)
+	require.Equal(t, nodeStatus.UpdatedAt, written.UpdatedAt)
+
+	// A recorder without a sink can't write statuses.
+	noSinkRecorder := NewMetricsRecorder(
+		roachpb.SystemTenantID, roachpb.NewTenantNameContainer(""), nil, nil, manual, st, nil)
+	err = noSinkRecorder.WriteNodeStatus(ctx, *nodeStatus, false /* mustExist */)
+	require.True(t, errors.IsAssertionFailure(err), "%v", err)
+}
+
+func TestMetricsRecorderWriteInfluxLineProtocol(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	manual := timeutil.NewManualTime(timeutil.Unix(0, 100))
+	st := cluster.MakeTestingClusterSettings()
+	recorder := NewMetricsRecorder(
+		roachpb.SystemTenantID, roachpb.NewTenantNameContainer(""), nil, nil, manual, st, nil)
+
+	newStore := func(storeID roachpb.StoreID, capacity, adds int64) fakeStore {
+		reg := metric.NewRegistry()
+		if capacity != 0 {
+			g := metric.NewGauge(metric.Metadata{Name: "capacity"})
+			g.Update(capacity)
+			reg.AddMetric(g)
+			c := metric.NewCounter(metric.Metadata{Name: "range.adds"})
+			c.Inc(adds)
+			reg.AddMetric(c)
+		}
+		return fakeStore{
+			storeID:  storeID,
+			desc:     roachpb.StoreDescriptor{StoreID: storeID},
+			registry: reg,
+		}
+	}
+	recorder.AddStore(newStore(2, 200, 5))
+	recorder.AddStore(newStore(1, 100, 3))
+	// A store without any metrics has nothing to report.
+	recorder.AddStore(newStore(3, 0, 0))
+
+	// Nothing is written until the node has been added.
+	var buf bytes.Buffer
+	require.NoError(t, recorder.WriteInfluxLineProtocol(&buf, "stores"))
+	require.Empty(t, buf.String())
+
+	recorder.AddNode(
+		metric.NewRegistry(), metric.NewRegistry(), metric.NewRegistry(), metric.NewRegistry(),
+		roachpb.NodeDescriptor{NodeID: roachpb.NodeID(7)}, 50, "foo:26257", "foo:26258", "foo:5432")
+
+	require.NoError(t, recorder.WriteInfluxLineProtocol(&buf, "cockroach stores"))
+	require.Equal(t,
+		`cockroach\ stores,node_id=7,store=1 capacity=100,range.adds=3 100
+cockroach\ stores,node_id=7,store=2 capacity=200,range.adds=5 100
+`, buf.String())
+}
+
 func BenchmarkExtractValueAllocs(b *testing.B) {
 	// Create a dummy histogram.
 	h := metric.NewHistogram(metric.HistogramOptions{
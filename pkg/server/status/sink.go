@@ -0,0 +1,175 @@
+// Copyright 2024 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/server/status/statuspb"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/errors"
+)
+
+var (
+	// errNodeStatusNotFound is returned by a StatusSink when asked to update a
+	// node status which does not exist.
+	errNodeStatusNotFound = errors.New("status entry not found, node may have been decommissioned")
+	// errNodeStatusChanged is returned by a StatusSink when a node status
+	// changed while it was being updated.
+	errNodeStatusChanged = errors.New("status entry unexpectedly changed during update")
+)
+
+// StatusSink is a destination for the node status summaries written by the
+// MetricsRecorder. The statuses of a node's stores are embedded in its node
+// status, so they are written along with it.
+type StatusSink interface {
+	// WriteNodeStatus persists the supplied summary. If mustExist is true, the
+	// summary must already exist and must not change while being updated;
+	// errNodeStatusNotFound or errNodeStatusChanged is returned otherwise.
+	WriteNodeStatus(ctx context.Context, nodeStatus *statuspb.NodeStatus, mustExist bool) error
+}
+
+// kvStatusSink is a StatusSink which writes node statuses to the node status
+// system keys.
+type kvStatusSink struct {
+	db *kv.DB
+}
+
+var _ StatusSink = kvStatusSink{}
+
+// NewKVStatusSink returns a StatusSink which writes node statuses to the KV
+// store through the given client.
+func NewKVStatusSink(db *kv.DB) StatusSink {
+	return kvStatusSink{db: db}
+}
+
+// WriteNodeStatus implements the StatusSink interface.
+func (s kvStatusSink) WriteNodeStatus(
+	ctx context.Context, nodeStatus *statuspb.NodeStatus, mustExist bool,
+) error {
+	key := keys.NodeStatusKey(nodeStatus.Desc.NodeID)
+	// We use an inline value to store only a single version of the node status.
+	// There's not much point in keeping the historical versions as we keep
+	// all of the constituent data as timeseries. Further, due to the size
+	// of the build info in the node status, writing one of these every 10s
+	// will generate more versions than will easily fit into a range over
+	// the course of a day.
+	if !mustExist {
+		return s.db.PutInline(ctx, key, nodeStatus)
+	}
+	entry, err := s.db.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if entry.Value == nil {
+		return errNodeStatusNotFound
+	}
+	err = s.db.CPutInline(ctx, key, nodeStatus, entry.Value.TagAndDataBytes())
+	if detail := (*kvpb.ConditionFailedError)(nil); errors.As(err, &detail) {
+		if detail.ActualValue == nil {
+			return errNodeStatusNotFound
+		}
+		return errNodeStatusChanged
+	}
+	return err
+}
+
+// logStatusSink is a StatusSink which only logs node statuses.
+type logStatusSink struct{}
+
+var _ StatusSink = logStatusSink{}
+
+// NewLogStatusSink returns a StatusSink which logs node statuses instead of
+// persisting them. Since nothing is persisted, mustExist is ignored.
+func NewLogStatusSink() StatusSink {
+	return logStatusSink{}
+}
+
+// WriteNodeStatus implements the StatusSink interface.
+func (logStatusSink) WriteNodeStatus(
+	ctx context.Context, nodeStatus *statuspb.NodeStatus, _ bool,
+) error {
+	statusJSON, err := json.Marshal(nodeStatus)
+	if err != nil {
+		return errors.Wrap(err, "marshaling node status")
+	}
+	log.Infof(ctx, "node %d status: %s", nodeStatus.Desc.NodeID, statusJSON)
+	return nil
+}
+
+// MemStatusSink is a StatusSink which keeps the most recently written status
+// of each node in memory. Errors can be injected into its writes, which makes
+// it suitable for testing.
+type MemStatusSink struct {
+	mu struct {
+		syncutil.Mutex
+		statuses map[roachpb.NodeID]*statuspb.NodeStatus
+		// injected holds errors to be returned, in order, by the next calls to
+		// WriteNodeStatus.
+		injected []error
+	}
+}
+
+var _ StatusSink = &MemStatusSink{}
+
+// NewMemStatusSink returns an empty MemStatusSink.
+func NewMemStatusSink() *MemStatusSink {
+	s := &MemStatusSink{}
+	s.mu.statuses = make(map[roachpb.NodeID]*statuspb.NodeStatus)
+	return s
+}
+
+// InjectErrors queues up errors to be returned by the next calls to
+// WriteNodeStatus, one per call. A write which returns an injected error has
+// no effect.
+func (s *MemStatusSink) InjectErrors(errs ...error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.injected = append(s.mu.injected, errs...)
+}
+
+// NodeStatus returns a copy of the status last written for the given node, if
+// any.
+func (s *MemStatusSink) NodeStatus(nodeID roachpb.NodeID) (*statuspb.NodeStatus, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ns, ok := s.mu.statuses[nodeID]
+	if !ok {
+		return nil, false
+	}
+	return protoutil.Clone(ns).(*statuspb.NodeStatus), true
+}
+
+// WriteNodeStatus implements the StatusSink interface.
+func (s *MemStatusSink) WriteNodeStatus(
+	_ context.Context, nodeStatus *statuspb.NodeStatus, mustExist bool,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.mu.injected) > 0 {
+		err := s.mu.injected[0]
+		s.mu.injected = s.mu.injected[1:]
+		return err
+	}
+	nodeID := nodeStatus.Desc.NodeID
+	if _, ok := s.mu.statuses[nodeID]; mustExist && !ok {
+		return errNodeStatusNotFound
+	}
+	s.mu.statuses[nodeID] = protoutil.Clone(nodeStatus).(*statuspb.NodeStatus)
+	return nil
+}
@@ -1288,7 +1288,7 @@ func makeTenantSQLServerArgs(
 
 	recorder := status.NewMetricsRecorder(
 		sqlCfg.TenantID, tenantNameContainer, nil /* nodeLiveness */, nil, /* remoteClocks */
-		clock.WallClock(), st)
+		clock.WallClock(), st, nil /* sink */)
 
 	var runtime *status.RuntimeStatSampler
 	if baseCfg.RuntimeStatSampler != nil {